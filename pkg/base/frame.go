@@ -0,0 +1,69 @@
+package base
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+// interleavedFrameMagicByte marks the start of an interleaved binary RTP/RTCP
+// frame on an RTSP TCP connection, as opposed to a textual request or response.
+const interleavedFrameMagicByte = 0x24
+
+// MaxInterleavedFramePayloadSize is the maximum size accepted for the
+// payload of an interleaved frame read by Response.ReadWithFrames. Callers
+// can lower or raise it to bound how much memory a malicious or broken peer
+// can force the library to allocate.
+var MaxInterleavedFramePayloadSize = 5 * 1024 * 1024
+
+// readInterleavedFrameHeader reads the 3 bytes that follow the magic byte
+// and returns the channel number and the payload length.
+func readInterleavedFrameHeader(rb *bufio.Reader) (int, int, error) {
+	var header [3]byte
+	if _, err := io.ReadFull(rb, header[:]); err != nil {
+		return 0, 0, err
+	}
+
+	channel := int(header[0])
+	plen := int(binary.BigEndian.Uint16(header[1:]))
+	return channel, plen, nil
+}
+
+// discardInterleavedFrame reads and discards an interleaved frame, after its
+// magic byte has already been consumed.
+func discardInterleavedFrame(rb *bufio.Reader, buf []byte) error {
+	_, plen, err := readInterleavedFrameHeader(rb)
+	if err != nil {
+		return err
+	}
+
+	if plen <= len(buf) {
+		_, err = io.ReadFull(rb, buf[:plen])
+		return err
+	}
+
+	_, err = io.CopyN(io.Discard, rb, int64(plen))
+	return err
+}
+
+// readInterleavedFramePayload reads an interleaved frame payload of the
+// given length into buf, reusing it when it fits and allocating a new one
+// otherwise. It errors out, without allocating, when plen exceeds
+// MaxInterleavedFramePayloadSize.
+func readInterleavedFramePayload(rb *bufio.Reader, plen int, buf []byte) ([]byte, error) {
+	if plen > MaxInterleavedFramePayloadSize {
+		return nil, fmt.Errorf("interleaved frame payload size (%d) exceeds maximum (%d)",
+			plen, MaxInterleavedFramePayloadSize)
+	}
+
+	if plen > len(buf) {
+		buf = make([]byte, plen)
+	}
+
+	if _, err := io.ReadFull(rb, buf[:plen]); err != nil {
+		return nil, err
+	}
+
+	return buf[:plen], nil
+}
@@ -0,0 +1,187 @@
+package base
+
+import (
+	"bufio"
+	"net/textproto"
+	"sort"
+	"strings"
+)
+
+// HeaderValue is the value of an header.
+type HeaderValue []string
+
+// Header is a RTSP reader header.
+type Header map[string]HeaderValue
+
+// multiValueHeaders lists the headers whose value is defined by RFC 2068
+// §4.2 as a comma-separated list, and can therefore be split into multiple
+// HeaderValue entries when it arrives on a single line. Every other header
+// is treated as opaque, since values like Date, Range, Session and
+// WWW-Authenticate legitimately contain commas inside a single value and
+// must not be torn apart.
+var multiValueHeaders = map[string]struct{}{
+	"Public":           {},
+	"Allow":            {},
+	"Transport":        {},
+	"Transports":       {},
+	"Accept":           {},
+	"Require":          {},
+	"Supported":        {},
+	"Accept-Ranges":    {}, // RTSP 2.0
+	"Media-Properties": {}, // RTSP 2.0
+}
+
+// headerKeyNormalize normalizes an header key in order to fix common
+// capitalization mistakes and to avoid the creation of duplicate keys
+// with different capitalization.
+func headerKeyNormalize(in string) string {
+	key := textproto.CanonicalMIMEHeaderKey(in)
+	switch key {
+	case "Cseq":
+		return "CSeq"
+	case "Www-Authenticate":
+		return "WWW-Authenticate"
+	case "Rtp-Info":
+		return "RTP-Info"
+	}
+	return key
+}
+
+// splitHeaderValue splits a comma-separated header value into its parts,
+// without splitting inside double-quoted strings or RFC 822 "()" comments,
+// and without being confused by a backslash-escaped quote, paren or comma
+// inside either of them.
+func splitHeaderValue(in string) []string {
+	var values []string
+	var cur strings.Builder
+	inQuotes := false
+	commentDepth := 0
+	escaped := false
+
+	for _, c := range in {
+		switch {
+		case escaped:
+			escaped = false
+			cur.WriteRune(c)
+
+		case c == '\\' && (inQuotes || commentDepth > 0):
+			escaped = true
+			cur.WriteRune(c)
+
+		case c == '"' && commentDepth == 0:
+			inQuotes = !inQuotes
+			cur.WriteRune(c)
+
+		case c == '(' && !inQuotes:
+			commentDepth++
+			cur.WriteRune(c)
+
+		case c == ')' && !inQuotes && commentDepth > 0:
+			commentDepth--
+			cur.WriteRune(c)
+
+		case c == ',' && !inQuotes && commentDepth == 0:
+			values = append(values, strings.TrimSpace(cur.String()))
+			cur.Reset()
+
+		default:
+			cur.WriteRune(c)
+		}
+	}
+	values = append(values, strings.TrimSpace(cur.String()))
+
+	return values
+}
+
+// read reads a header from a buffered reader.
+func (h *Header) read(rb *bufio.Reader) error {
+	*h = make(Header)
+
+	for {
+		byt, err := rb.ReadByte()
+		if err != nil {
+			return err
+		}
+
+		if byt == '\r' {
+			if err := readByteEqual(rb, '\n'); err != nil {
+				return err
+			}
+			break
+		}
+
+		if err := rb.UnreadByte(); err != nil {
+			return err
+		}
+
+		byts, err := readBytesLimited(rb, ':', 1000)
+		if err != nil {
+			return err
+		}
+		key := headerKeyNormalize(string(byts[:len(byts)-1]))
+
+		// skip spaces after the colon
+		for {
+			byt, err := rb.ReadByte()
+			if err != nil {
+				return err
+			}
+			if byt != ' ' {
+				if err := rb.UnreadByte(); err != nil {
+					return err
+				}
+				break
+			}
+		}
+
+		byts, err = readBytesLimited(rb, '\r', 1000)
+		if err != nil {
+			return err
+		}
+		val := string(byts[:len(byts)-1])
+
+		if err := readByteEqual(rb, '\n'); err != nil {
+			return err
+		}
+
+		var values []string
+		if _, ok := multiValueHeaders[key]; ok {
+			values = splitHeaderValue(val)
+		} else {
+			values = []string{val}
+		}
+
+		(*h)[key] = append((*h)[key], values...)
+	}
+
+	return nil
+}
+
+// write writes a header into a buffered writer.
+func (h Header) write(bw *bufio.Writer) error {
+	keys := make([]string, 0, len(h))
+	for key := range h {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if _, ok := multiValueHeaders[key]; ok {
+			_, err := bw.Write([]byte(key + ": " + strings.Join(h[key], ", ") + "\r\n"))
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		for _, val := range h[key] {
+			_, err := bw.Write([]byte(key + ": " + val + "\r\n"))
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	_, err := bw.Write([]byte("\r\n"))
+	return err
+}
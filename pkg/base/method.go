@@ -0,0 +1,19 @@
+package base
+
+// Method is the method of a RTSP request.
+type Method string
+
+// Methods.
+const (
+	Announce     Method = "ANNOUNCE"
+	Describe     Method = "DESCRIBE"
+	GetParameter Method = "GET_PARAMETER"
+	Options      Method = "OPTIONS"
+	Pause        Method = "PAUSE"
+	Play         Method = "PLAY"
+	Record       Method = "RECORD"
+	Redirect     Method = "REDIRECT"
+	SetParameter Method = "SET_PARAMETER"
+	Setup        Method = "SETUP"
+	Teardown     Method = "TEARDOWN"
+)
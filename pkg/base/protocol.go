@@ -0,0 +1,24 @@
+package base
+
+// Protocol is the version of the RTSP protocol used by a Request or a Response.
+type Protocol int
+
+// Protocols.
+const (
+	// ProtocolRTSP1 is RTSP 1.0 (RFC 2326).
+	ProtocolRTSP1 Protocol = iota
+
+	// ProtocolRTSP2 is RTSP 2.0 (RFC 7826).
+	ProtocolRTSP2
+)
+
+// String implements fmt.Stringer.
+func (p Protocol) String() string {
+	switch p {
+	case ProtocolRTSP1:
+		return rtspProtocol10
+	case ProtocolRTSP2:
+		return rtspProtocol20
+	}
+	return "unknown"
+}
@@ -0,0 +1,106 @@
+package base
+
+import (
+	"bufio"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// Request is a RTSP request.
+type Request struct {
+	// Protocol is the protocol version of the request. It is filled
+	// automatically by Read, and defaults to ProtocolRTSP1 when unset.
+	Protocol Protocol
+
+	// Method is the method of the request.
+	Method Method
+
+	// URL is the request URL.
+	URL *url.URL
+
+	// Header contains the request header.
+	Header Header
+
+	// Body contains the request body.
+	Body []byte
+}
+
+// Read reads a request from a buffered reader.
+func (req *Request) Read(rb *bufio.Reader) error {
+	byts, err := readBytesLimited(rb, ' ', 255)
+	if err != nil {
+		return err
+	}
+	req.Method = Method(byts[:len(byts)-1])
+
+	byts, err = readBytesLimited(rb, ' ', 2048)
+	if err != nil {
+		return err
+	}
+	rawURL := string(byts[:len(byts)-1])
+
+	ur, err := url.Parse(rawURL)
+	if err != nil {
+		return fmt.Errorf("invalid URL: %s", rawURL)
+	}
+	req.URL = ur
+
+	byts, err = readBytesLimited(rb, '\r', 255)
+	if err != nil {
+		return err
+	}
+	proto, err := parseProtocol(string(byts[:len(byts)-1]))
+	if err != nil {
+		return err
+	}
+	req.Protocol = proto
+
+	if err := readByteEqual(rb, '\n'); err != nil {
+		return err
+	}
+
+	if err := req.Header.read(rb); err != nil {
+		return err
+	}
+
+	if err := readContentLength(&req.Body, req.Header, rb); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Write writes a request into a buffered writer.
+func (req Request) Write(bw *bufio.Writer) error {
+	if req.Protocol != ProtocolRTSP1 && req.Protocol != ProtocolRTSP2 {
+		return fmt.Errorf("invalid protocol: %v", req.Protocol)
+	}
+
+	urStr := "*"
+	if req.URL != nil {
+		urStr = req.URL.String()
+	}
+
+	_, err := bw.Write([]byte(string(req.Method) + " " + urStr + " " + req.Protocol.String() + "\r\n"))
+	if err != nil {
+		return err
+	}
+
+	if len(req.Body) != 0 {
+		if req.Header == nil {
+			req.Header = make(Header)
+		}
+		req.Header["Content-Length"] = HeaderValue{strconv.FormatInt(int64(len(req.Body)), 10)}
+	}
+
+	if err := req.Header.write(bw); err != nil {
+		return err
+	}
+
+	if _, err := bw.Write(req.Body); err != nil {
+		return err
+	}
+
+	return bw.Flush()
+}
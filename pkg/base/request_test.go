@@ -0,0 +1,87 @@
+package base
+
+import (
+	"bufio"
+	"bytes"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type requestCase struct {
+	name string
+	byts []byte
+	req  Request
+}
+
+func (c requestCase) toRTSP2() requestCase {
+	req := c.req
+	req.Protocol = ProtocolRTSP2
+	return requestCase{
+		name: c.name + " (RTSP/2.0)",
+		byts: bytes.Replace(c.byts, []byte(rtspProtocol10), []byte(rtspProtocol20), 1),
+		req:  req,
+	}
+}
+
+var casesRequest = []requestCase{
+	{
+		"options",
+		[]byte("OPTIONS rtsp://example.com/media.mp4 RTSP/1.0\r\n" +
+			"CSeq: 1\r\n" +
+			"\r\n",
+		),
+		Request{
+			Method: Options,
+			URL: &url.URL{
+				Scheme: "rtsp",
+				Host:   "example.com",
+				Path:   "/media.mp4",
+			},
+			Header: Header{
+				"CSeq": HeaderValue{"1"},
+			},
+		},
+	},
+}
+
+func allCasesRequest() []requestCase {
+	cases := make([]requestCase, 0, len(casesRequest)*2)
+	for _, c := range casesRequest {
+		cases = append(cases, c, c.toRTSP2())
+	}
+	return cases
+}
+
+func TestRequestRead(t *testing.T) {
+	var req Request
+
+	for _, c := range allCasesRequest() {
+		t.Run(c.name, func(t *testing.T) {
+			err := req.Read(bufio.NewReader(bytes.NewBuffer(c.byts)))
+			require.NoError(t, err)
+			require.Equal(t, c.req, req)
+		})
+	}
+}
+
+func TestRequestWrite(t *testing.T) {
+	for _, c := range allCasesRequest() {
+		t.Run(c.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			bw := bufio.NewWriter(&buf)
+			err := c.req.Write(bw)
+			require.NoError(t, err)
+			require.Equal(t, c.byts, buf.Bytes())
+		})
+	}
+}
+
+func TestRequestWriteInvalidProtocol(t *testing.T) {
+	req := Request{Method: Options, Protocol: Protocol(99)}
+	var buf bytes.Buffer
+	bw := bufio.NewWriter(&buf)
+	err := req.Write(bw)
+	require.Error(t, err)
+}
@@ -0,0 +1,191 @@
+package base
+
+import (
+	"bufio"
+	"fmt"
+	"strconv"
+)
+
+// Response is a RTSP response.
+type Response struct {
+	// Protocol is the protocol version of the response. It is filled
+	// automatically by Read, and defaults to ProtocolRTSP1 when unset.
+	Protocol Protocol
+
+	// StatusCode is the status code of the response.
+	StatusCode StatusCode
+
+	// StatusMessage is the status message of the response. If left empty,
+	// it is autofilled from StatusCode when the response is written.
+	StatusMessage string
+
+	// Header contains the response header.
+	Header Header
+
+	// Body contains the response body.
+	Body []byte
+}
+
+// Read reads a response from a buffered reader.
+func (res *Response) Read(rb *bufio.Reader) error {
+	byts, err := readBytesLimited(rb, ' ', 255)
+	if err != nil {
+		return err
+	}
+	proto, err := parseProtocol(string(byts[:len(byts)-1]))
+	if err != nil {
+		return err
+	}
+	res.Protocol = proto
+
+	byts, err = readBytesLimited(rb, ' ', 4)
+	if err != nil {
+		return err
+	}
+	statusCodeStr := string(byts[:len(byts)-1])
+	statusCode64, err := strconv.ParseInt(statusCodeStr, 10, 32)
+	if err != nil {
+		return fmt.Errorf("invalid status code: %s", statusCodeStr)
+	}
+	res.StatusCode = StatusCode(statusCode64)
+
+	byts, err = readBytesLimited(rb, '\r', 255)
+	if err != nil {
+		return err
+	}
+	res.StatusMessage = string(byts[:len(byts)-1])
+	if len(res.StatusMessage) == 0 {
+		return fmt.Errorf("empty status message")
+	}
+
+	if err := readByteEqual(rb, '\n'); err != nil {
+		return err
+	}
+
+	if err := res.Header.read(rb); err != nil {
+		return err
+	}
+
+	if err := readContentLength(&res.Body, res.Header, rb); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Write writes a response into a buffered writer.
+func (res Response) Write(bw *bufio.Writer) error {
+	if res.Protocol != ProtocolRTSP1 && res.Protocol != ProtocolRTSP2 {
+		return fmt.Errorf("invalid protocol: %v", res.Protocol)
+	}
+
+	if res.StatusMessage == "" {
+		if msg, ok := statusMessages[res.StatusCode]; ok {
+			res.StatusMessage = msg
+		}
+	}
+
+	_, err := bw.Write([]byte(res.Protocol.String() + " " +
+		strconv.FormatInt(int64(res.StatusCode), 10) + " " + res.StatusMessage + "\r\n"))
+	if err != nil {
+		return err
+	}
+
+	if len(res.Body) != 0 {
+		if res.Header == nil {
+			res.Header = make(Header)
+		}
+		res.Header["Content-Length"] = HeaderValue{strconv.FormatInt(int64(len(res.Body)), 10)}
+	}
+
+	if err := res.Header.write(bw); err != nil {
+		return err
+	}
+
+	if _, err := bw.Write(res.Body); err != nil {
+		return err
+	}
+
+	return bw.Flush()
+}
+
+// ReadIgnoreFrames reads a response from a buffered reader, discarding any
+// interleaved RTP/RTCP frame that is encountered while waiting for it.
+// RTSP 2.0 forbids interleaved binary framing on the connection used for
+// requests and responses, so knownProtocol must carry the protocol version
+// already negotiated on this connection; this errors out when it is
+// ProtocolRTSP2.
+func (res *Response) ReadIgnoreFrames(rb *bufio.Reader, knownProtocol Protocol, frameBuf []byte) error {
+	if knownProtocol == ProtocolRTSP2 {
+		return fmt.Errorf("interleaved frames are not supported in RTSP 2.0")
+	}
+
+	for {
+		byt, err := rb.ReadByte()
+		if err != nil {
+			return err
+		}
+
+		if byt == interleavedFrameMagicByte {
+			if err := discardInterleavedFrame(rb, frameBuf); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err := rb.UnreadByte(); err != nil {
+			return err
+		}
+
+		return res.Read(rb)
+	}
+}
+
+// ReadWithFrames reads a response from a buffered reader, dispatching any
+// interleaved RTP/RTCP frame encountered along the way to onFrame instead of
+// discarding it. frameBuf is reused for the payload when it's large enough,
+// and a new buffer is allocated otherwise. It only returns once the response
+// has been fully read. RTSP 2.0 forbids interleaved binary framing on the
+// connection used for requests and responses, so knownProtocol must carry
+// the protocol version already negotiated on this connection; this errors
+// out when it is ProtocolRTSP2.
+func (res *Response) ReadWithFrames(
+	rb *bufio.Reader,
+	knownProtocol Protocol,
+	frameBuf []byte,
+	onFrame func(channel int, payload []byte) error,
+) error {
+	if knownProtocol == ProtocolRTSP2 {
+		return fmt.Errorf("interleaved frames are not supported in RTSP 2.0")
+	}
+
+	for {
+		byt, err := rb.ReadByte()
+		if err != nil {
+			return err
+		}
+
+		if byt == interleavedFrameMagicByte {
+			channel, plen, err := readInterleavedFrameHeader(rb)
+			if err != nil {
+				return err
+			}
+
+			payload, err := readInterleavedFramePayload(rb, plen, frameBuf)
+			if err != nil {
+				return err
+			}
+
+			if err := onFrame(channel, payload); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err := rb.UnreadByte(); err != nil {
+			return err
+		}
+
+		return res.Read(rb)
+	}
+}
@@ -8,11 +8,26 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
-var casesResponse = []struct {
+type responseCase struct {
 	name string
 	byts []byte
 	res  Response
-}{
+}
+
+// toRTSP2 derives the RTSP 2.0 equivalent of a RTSP 1.0 case, by switching
+// the protocol string on the wire and recording ProtocolRTSP2 on the
+// expected response.
+func (c responseCase) toRTSP2() responseCase {
+	res := c.res
+	res.Protocol = ProtocolRTSP2
+	return responseCase{
+		name: c.name + " (RTSP/2.0)",
+		byts: bytes.Replace(c.byts, []byte(rtspProtocol10), []byte(rtspProtocol20), 1),
+		res:  res,
+	}
+}
+
+var casesResponse = []responseCase{
 	{
 		"ok",
 		[]byte("RTSP/1.0 200 OK\r\n" +
@@ -90,13 +105,57 @@ var casesResponse = []struct {
 			),
 		},
 	},
+	{
+		"multi-value and non-multi-value headers on a single line",
+		[]byte("RTSP/1.0 200 OK\r\n" +
+			"CSeq: 3\r\n" +
+			"Public: DESCRIBE, SETUP, PLAY, PAUSE, TEARDOWN\r\n" +
+			"Range: npt=0-, npt=10-20\r\n" +
+			"\r\n",
+		),
+		Response{
+			StatusCode:    StatusOK,
+			StatusMessage: "OK",
+			Header: Header{
+				"CSeq":   HeaderValue{"3"},
+				"Public": HeaderValue{"DESCRIBE", "SETUP", "PLAY", "PAUSE", "TEARDOWN"},
+				"Range":  HeaderValue{"npt=0-, npt=10-20"},
+			},
+		},
+	},
+	{
+		"multi-value header with a comma inside a RFC 822 comment",
+		[]byte("RTSP/1.0 200 OK\r\n" +
+			"Allow: DESCRIBE, SETUP (legacy, unauthenticated), PLAY\r\n" +
+			"CSeq: 4\r\n" +
+			"\r\n",
+		),
+		Response{
+			StatusCode:    StatusOK,
+			StatusMessage: "OK",
+			Header: Header{
+				"CSeq":  HeaderValue{"4"},
+				"Allow": HeaderValue{"DESCRIBE", "SETUP (legacy, unauthenticated)", "PLAY"},
+			},
+		},
+	},
+}
+
+// allCasesResponse returns casesResponse plus, for each entry, its RTSP 2.0
+// equivalent, so that every case is exercised in both protocol versions.
+func allCasesResponse() []responseCase {
+	cases := make([]responseCase, 0, len(casesResponse)*2)
+	for _, c := range casesResponse {
+		cases = append(cases, c, c.toRTSP2())
+	}
+	return cases
 }
 
 func TestResponseRead(t *testing.T) {
 	// keep res global to make sure that all its fields are overridden.
 	var res Response
 
-	for _, c := range casesResponse {
+	for _, c := range allCasesResponse() {
 		t.Run(c.name, func(t *testing.T) {
 			err := res.Read(bufio.NewReader(bytes.NewBuffer(c.byts)))
 			require.NoError(t, err)
@@ -106,7 +165,7 @@ func TestResponseRead(t *testing.T) {
 }
 
 func TestResponseWrite(t *testing.T) {
-	for _, c := range casesResponse {
+	for _, c := range allCasesResponse() {
 		t.Run(c.name, func(t *testing.T) {
 			var buf bytes.Buffer
 			bw := bufio.NewWriter(&buf)
@@ -145,7 +204,7 @@ func TestResponseReadErrors(t *testing.T) {
 		},
 		{
 			"invalid protocol",
-			[]byte("RTSP/2.0 200 OK\r\n"),
+			[]byte("RTSP/3.0 200 OK\r\n"),
 		},
 		{
 			"invalid code",
@@ -203,6 +262,99 @@ func TestReadIgnoreFrames(t *testing.T) {
 	rb := bufio.NewReader(bytes.NewBuffer(byts))
 	buf := make([]byte, 10)
 	var res Response
-	err := res.ReadIgnoreFrames(rb, buf)
+	err := res.ReadIgnoreFrames(rb, ProtocolRTSP1, buf)
 	require.NoError(t, err)
 }
+
+func TestResponseReadWithFrames(t *testing.T) {
+	okResponse := []byte("RTSP/1.0 200 OK\r\n" +
+		"CSeq: 1\r\n" +
+		"Public: DESCRIBE, SETUP, TEARDOWN, PLAY, PAUSE\r\n" +
+		"\r\n")
+
+	t.Run("multiple frames before the response", func(t *testing.T) {
+		byts := []byte{0x24, 0x0, 0x0, 0x2, 0x1, 0x2}
+		byts = append(byts, 0x24, 0x1, 0x0, 0x3, 0x3, 0x4, 0x5)
+		byts = append(byts, okResponse...)
+
+		rb := bufio.NewReader(bytes.NewBuffer(byts))
+		frameBuf := make([]byte, 10)
+		var res Response
+		var frames [][]byte
+		var channels []int
+
+		err := res.ReadWithFrames(rb, ProtocolRTSP1, frameBuf, func(channel int, payload []byte) error {
+			channels = append(channels, channel)
+			frames = append(frames, append([]byte(nil), payload...))
+			return nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, []int{0, 1}, channels)
+		require.Equal(t, [][]byte{{0x1, 0x2}, {0x3, 0x4, 0x5}}, frames)
+		require.Equal(t, StatusOK, res.StatusCode)
+	})
+
+	t.Run("frame larger than frameBuf", func(t *testing.T) {
+		payload := []byte{1, 2, 3, 4, 5, 6, 7, 8}
+		byts := []byte{0x24, 0x0, 0x0, byte(len(payload))}
+		byts = append(byts, payload...)
+		byts = append(byts, okResponse...)
+
+		rb := bufio.NewReader(bytes.NewBuffer(byts))
+		frameBuf := make([]byte, 4)
+		var res Response
+		var received []byte
+
+		err := res.ReadWithFrames(rb, ProtocolRTSP1, frameBuf, func(channel int, p []byte) error {
+			received = append([]byte(nil), p...)
+			return nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, payload, received)
+	})
+
+	t.Run("frame exceeding the maximum payload size", func(t *testing.T) {
+		prevMax := MaxInterleavedFramePayloadSize
+		MaxInterleavedFramePayloadSize = 4
+		defer func() { MaxInterleavedFramePayloadSize = prevMax }()
+
+		byts := []byte{0x24, 0x0, 0x0, 0x5, 0x1, 0x2, 0x3, 0x4, 0x5}
+		byts = append(byts, okResponse...)
+
+		rb := bufio.NewReader(bytes.NewBuffer(byts))
+		frameBuf := make([]byte, 10)
+		var res Response
+
+		err := res.ReadWithFrames(rb, ProtocolRTSP1, frameBuf, func(channel int, p []byte) error {
+			t.Fatal("onFrame should not be called")
+			return nil
+		})
+		require.Error(t, err)
+	})
+
+	t.Run("rejected on a RTSP 2.0 connection", func(t *testing.T) {
+		rb := bufio.NewReader(bytes.NewBuffer(okResponse))
+		var res Response
+
+		err := res.ReadWithFrames(rb, ProtocolRTSP2, make([]byte, 10), func(channel int, p []byte) error {
+			t.Fatal("onFrame should not be called")
+			return nil
+		})
+		require.Error(t, err)
+	})
+}
+
+func TestResponseWriteInvalidProtocol(t *testing.T) {
+	res := Response{StatusCode: StatusOK, Protocol: Protocol(99)}
+	var buf bytes.Buffer
+	bw := bufio.NewWriter(&buf)
+	err := res.Write(bw)
+	require.Error(t, err)
+}
+
+func TestReadIgnoreFramesRejectedOnRTSP2(t *testing.T) {
+	rb := bufio.NewReader(bytes.NewBuffer([]byte("RTSP/2.0 200 OK\r\n\r\n")))
+	var res Response
+	err := res.ReadIgnoreFrames(rb, ProtocolRTSP2, make([]byte, 10))
+	require.Error(t, err)
+}
@@ -0,0 +1,95 @@
+package base
+
+// StatusCode is the status code of a RTSP response.
+type StatusCode int
+
+// Status codes.
+const (
+	StatusContinue                        StatusCode = 100
+	StatusOK                              StatusCode = 200
+	StatusMovedPermanently                StatusCode = 301
+	StatusFound                           StatusCode = 302
+	StatusSeeOther                        StatusCode = 303
+	StatusNotModified                     StatusCode = 304
+	StatusUseProxy                        StatusCode = 305
+	StatusBadRequest                      StatusCode = 400
+	StatusUnauthorized                    StatusCode = 401
+	StatusPaymentRequired                 StatusCode = 402
+	StatusForbidden                       StatusCode = 403
+	StatusNotFound                        StatusCode = 404
+	StatusMethodNotAllowed                StatusCode = 405
+	StatusNotAcceptable                   StatusCode = 406
+	StatusProxyAuthRequired               StatusCode = 407
+	StatusRequestTimeout                  StatusCode = 408
+	StatusGone                            StatusCode = 410
+	StatusPreconditionFailed              StatusCode = 412
+	StatusRequestEntityTooLarge           StatusCode = 413
+	StatusRequestURITooLong               StatusCode = 414
+	StatusUnsupportedMediaType            StatusCode = 415
+	StatusParameterNotUnderstood          StatusCode = 451
+	StatusNotEnoughBandwidth              StatusCode = 453
+	StatusSessionNotFound                 StatusCode = 454
+	StatusMethodNotValidInThisState       StatusCode = 455
+	StatusHeaderFieldNotValid             StatusCode = 456
+	StatusInvalidRange                    StatusCode = 457
+	StatusParameterIsReadOnly             StatusCode = 458
+	StatusAggregateOperationNotAllowed    StatusCode = 459
+	StatusOnlyAggregateOperationAllowed   StatusCode = 460
+	StatusUnsupportedTransport            StatusCode = 461
+	StatusDestinationUnreachable          StatusCode = 462
+	StatusDataTransportNotReadyYet        StatusCode = 464 // RTSP 2.0 only
+	StatusConnectionAuthorizationRequired StatusCode = 470 // RTSP 2.0 only
+	StatusInternalServerError             StatusCode = 500
+	StatusNotImplemented                  StatusCode = 501
+	StatusBadGateway                      StatusCode = 502
+	StatusServiceUnavailable              StatusCode = 503
+	StatusGatewayTimeout                  StatusCode = 504
+	StatusRTSPVersionNotSupported         StatusCode = 505
+	StatusOptionNotSupported              StatusCode = 551
+)
+
+// statusMessages contains the default status message for each status code,
+// used to autofill Response.StatusMessage when it is left empty.
+var statusMessages = map[StatusCode]string{
+	StatusContinue:                        "Continue",
+	StatusOK:                              "OK",
+	StatusMovedPermanently:                "Moved Permanently",
+	StatusFound:                           "Found",
+	StatusSeeOther:                        "See Other",
+	StatusNotModified:                     "Not Modified",
+	StatusUseProxy:                        "Use Proxy",
+	StatusBadRequest:                      "Bad Request",
+	StatusUnauthorized:                    "Unauthorized",
+	StatusPaymentRequired:                 "Payment Required",
+	StatusForbidden:                       "Forbidden",
+	StatusNotFound:                        "Not Found",
+	StatusMethodNotAllowed:                "Method Not Allowed",
+	StatusNotAcceptable:                   "Not Acceptable",
+	StatusProxyAuthRequired:               "Proxy Auth Required",
+	StatusRequestTimeout:                  "Request Timeout",
+	StatusGone:                            "Gone",
+	StatusPreconditionFailed:              "Precondition Failed",
+	StatusRequestEntityTooLarge:           "Request Entity Too Large",
+	StatusRequestURITooLong:               "Request URI Too Long",
+	StatusUnsupportedMediaType:            "Unsupported Media Type",
+	StatusParameterNotUnderstood:          "Parameter Not Understood",
+	StatusNotEnoughBandwidth:              "Not Enough Bandwidth",
+	StatusSessionNotFound:                 "Session Not Found",
+	StatusMethodNotValidInThisState:       "Method Not Valid In This State",
+	StatusHeaderFieldNotValid:             "Header Field Not Valid",
+	StatusInvalidRange:                    "Invalid Range",
+	StatusParameterIsReadOnly:             "Parameter Is Read-Only",
+	StatusAggregateOperationNotAllowed:    "Aggregate Operation Not Allowed",
+	StatusOnlyAggregateOperationAllowed:   "Only Aggregate Operation Allowed",
+	StatusUnsupportedTransport:            "Unsupported Transport",
+	StatusDestinationUnreachable:          "Destination Unreachable",
+	StatusDataTransportNotReadyYet:        "Data Transport Not Ready Yet",
+	StatusConnectionAuthorizationRequired: "Connection Authorization Required",
+	StatusInternalServerError:             "Internal Server Error",
+	StatusNotImplemented:                  "Not Implemented",
+	StatusBadGateway:                      "Bad Gateway",
+	StatusServiceUnavailable:              "Service Unavailable",
+	StatusGatewayTimeout:                  "Gateway Timeout",
+	StatusRTSPVersionNotSupported:         "RTSP Version Not Supported",
+	StatusOptionNotSupported:              "Option Not Supported",
+}
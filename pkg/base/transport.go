@@ -0,0 +1,21 @@
+package base
+
+import (
+	"context"
+	"io"
+)
+
+// Transport is the minimal interface a RTSP transport backend must satisfy
+// in order to drive a client built on top of Request and Response, which
+// form the wire-independent contract between gortsplib and any backend
+// (TCP, UDP, or a third-party implementation).
+type Transport interface {
+	// Do sends req and returns the associated response.
+	Do(ctx context.Context, req *Request) (*Response, error)
+
+	// OpenInterleaved opens channel ch for interleaved RTP/RTCP traffic.
+	OpenInterleaved(ch int) (io.ReadWriteCloser, error)
+
+	// Close closes the transport.
+	Close() error
+}
@@ -0,0 +1,64 @@
+// Package transporttest provides an in-memory base.Transport for driving
+// higher-level tests without opening real sockets.
+package transporttest
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+
+	"github.com/richard-austin/gortsplib/pkg/base"
+)
+
+// Exchange is a canned request/response pair.
+type Exchange struct {
+	Req *base.Request
+	Res *base.Response
+}
+
+// Transport is a base.Transport that replays a fixed sequence of Exchanges
+// in order, regardless of the request it is given.
+type Transport struct {
+	mu        sync.Mutex
+	exchanges []Exchange
+	pos       int
+	closed    bool
+}
+
+// New allocates a Transport that replays exchanges in order.
+func New(exchanges []Exchange) *Transport {
+	return &Transport{exchanges: exchanges}
+}
+
+// Do implements base.Transport.
+func (t *Transport) Do(_ context.Context, _ *base.Request) (*base.Response, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.closed {
+		return nil, errors.New("transport is closed")
+	}
+
+	if t.pos >= len(t.exchanges) {
+		return nil, errors.New("no more canned exchanges")
+	}
+
+	res := t.exchanges[t.pos].Res
+	t.pos++
+	return res, nil
+}
+
+// OpenInterleaved implements base.Transport. Interleaved channels are not
+// supported by this in-memory transport.
+func (t *Transport) OpenInterleaved(int) (io.ReadWriteCloser, error) {
+	return nil, errors.New("interleaved channels are not supported by transporttest.Transport")
+}
+
+// Close implements base.Transport.
+func (t *Transport) Close() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.closed = true
+	return nil
+}
@@ -0,0 +1,48 @@
+package transporttest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/richard-austin/gortsplib/pkg/base"
+)
+
+func TestTransportDo(t *testing.T) {
+	res1 := &base.Response{StatusCode: base.StatusOK}
+	res2 := &base.Response{StatusCode: base.StatusNotFound}
+
+	tr := New([]Exchange{
+		{Req: &base.Request{Method: base.Describe}, Res: res1},
+		{Req: &base.Request{Method: base.Options}, Res: res2},
+	})
+
+	res, err := tr.Do(context.Background(), &base.Request{Method: base.Describe})
+	require.NoError(t, err)
+	require.Equal(t, res1, res)
+
+	res, err = tr.Do(context.Background(), &base.Request{Method: base.Options})
+	require.NoError(t, err)
+	require.Equal(t, res2, res)
+
+	_, err = tr.Do(context.Background(), &base.Request{Method: base.Options})
+	require.Error(t, err)
+}
+
+func TestTransportClose(t *testing.T) {
+	tr := New([]Exchange{
+		{Req: &base.Request{Method: base.Describe}, Res: &base.Response{StatusCode: base.StatusOK}},
+	})
+
+	require.NoError(t, tr.Close())
+
+	_, err := tr.Do(context.Background(), &base.Request{Method: base.Describe})
+	require.Error(t, err)
+}
+
+func TestTransportOpenInterleavedUnsupported(t *testing.T) {
+	tr := New(nil)
+	_, err := tr.OpenInterleaved(0)
+	require.Error(t, err)
+}
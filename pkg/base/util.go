@@ -0,0 +1,91 @@
+package base
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// rtspProtocol10 is the protocol string used by RTSP 1.0 requests and responses.
+const rtspProtocol10 = "RTSP/1.0"
+
+// rtspProtocol20 is the protocol string used by RTSP 2.0 requests and responses.
+const rtspProtocol20 = "RTSP/2.0"
+
+// parseProtocol converts a protocol string read off the wire into a Protocol,
+// erroring out if it's neither RTSP 1.0 nor RTSP 2.0.
+func parseProtocol(in string) (Protocol, error) {
+	switch in {
+	case rtspProtocol10:
+		return ProtocolRTSP1, nil
+	case rtspProtocol20:
+		return ProtocolRTSP2, nil
+	}
+	return 0, fmt.Errorf("invalid protocol: %s", in)
+}
+
+// readBytesLimited reads a sequence of bytes up to and including delim,
+// erroring out if delim is not found within n bytes.
+func readBytesLimited(rb *bufio.Reader, delim byte, n int) ([]byte, error) {
+	for i := 1; i <= n; i++ {
+		byts, err := rb.Peek(i)
+		if err != nil {
+			return nil, err
+		}
+
+		if byts[len(byts)-1] == delim {
+			rb.Discard(len(byts)) //nolint:errcheck
+			return byts, nil
+		}
+	}
+	return nil, fmt.Errorf("delimiter not found within %d bytes", n)
+}
+
+// readByteEqual reads a single byte and errors out if it doesn't match expected.
+func readByteEqual(rb *bufio.Reader, expected byte) error {
+	byt, err := rb.ReadByte()
+	if err != nil {
+		return err
+	}
+
+	if byt != expected {
+		return fmt.Errorf("expected '%c', got '%c'", expected, byt)
+	}
+
+	return nil
+}
+
+// MaxBodySize is the maximum value accepted in a Content-Length header.
+// Requests and responses declaring a larger body are rejected before any
+// allocation is made, so that a malicious peer cannot exhaust memory by
+// announcing an oversized body.
+var MaxBodySize int64 = 12 * 1024 * 1024
+
+// readContentLength reads a body from rb whose length is given by the
+// Content-Length header, if present. If the header is absent, body is set
+// to nil.
+func readContentLength(body *[]byte, h Header, rb *bufio.Reader) error {
+	cl, ok := h["Content-Length"]
+	if !ok || len(cl) == 0 {
+		*body = nil
+		return nil
+	}
+
+	n, err := strconv.ParseInt(cl[0], 10, 64)
+	if err != nil || n < 0 {
+		return fmt.Errorf("invalid Content-Length: %s", cl[0])
+	}
+
+	if n > MaxBodySize {
+		return fmt.Errorf("Content-Length (%d) exceeds maximum (%d)", n, MaxBodySize)
+	}
+
+	buf := make([]byte, n)
+	if _, err := io.ReadFull(rb, buf); err != nil {
+		return err
+	}
+	*body = buf
+
+	return nil
+}